@@ -0,0 +1,47 @@
+package main
+
+import "strings"
+
+// parseCSV splits a comma-separated flag value into its trimmed, non-empty
+// parts. An empty or blank s yields nil.
+func parseCSV(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// toSet turns a comma-separated flag value into a lookup set.
+func toSet(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, v := range parseCSV(s) {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// filterTables applies an optional allowlist and an exclude list to tables,
+// preserving order.
+func filterTables(tables []string, allow, exclude map[string]struct{}) []string {
+	filtered := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if len(allow) > 0 {
+			if _, ok := allow[table]; !ok {
+				continue
+			}
+		}
+		if _, ok := exclude[table]; ok {
+			continue
+		}
+		filtered = append(filtered, table)
+	}
+	return filtered
+}
@@ -0,0 +1,35 @@
+package main
+
+import "context"
+
+// ColumnInfo describes a single column as reported by a driver's
+// introspection queries.
+type ColumnInfo struct {
+	Name         string
+	DataType     string
+	IsNullable   bool
+	IsPrimaryKey bool
+	ForeignKey   *ForeignKey
+}
+
+// ForeignKey describes a column's reference to another table's column.
+type ForeignKey struct {
+	Table  string
+	Column string
+}
+
+// DbTransformer abstracts the schema introspection and type mapping needed
+// to generate bun models for a particular database dialect. Each supported
+// driver (Postgres, MySQL, SQLite) provides its own implementation using
+// whatever introspection mechanism that dialect exposes.
+type DbTransformer interface {
+	// FetchTables returns the list of table names to generate models for.
+	FetchTables(ctx context.Context) ([]string, error)
+	// FetchColumns returns column metadata for the given table, including
+	// nullability, primary key membership, and foreign key references.
+	FetchColumns(ctx context.Context, tableName string) ([]ColumnInfo, error)
+	// MapColumnType maps a dialect-native column type to a Go type. It
+	// returns an error instead of falling back to "interface{}" when the
+	// transformer was constructed in strict mode and dataType is unknown.
+	MapColumnType(dataType string) (string, error)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// MigrationFile is a single generated migration file, ready to be written
+// into the migrations output directory.
+type MigrationFile struct {
+	Name string // e.g. 20060102150405_create_users.go
+	Code string
+}
+
+const migrationTemplateSrc = `package {{.Package}}
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+{{range .Up}}		if _, err := db.ExecContext(ctx, {{printf "%q" .}}); err != nil {
+			return err
+		}
+{{end}}		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+{{range .Down}}		if _, err := db.ExecContext(ctx, {{printf "%q" .}}); err != nil {
+			return err
+		}
+{{end}}		return nil
+	})
+}
+`
+
+var migrationTemplate = template.Must(template.New("migration").Parse(migrationTemplateSrc))
+
+func renderMigration(data interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := migrationTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}
+
+// GenerateMigrations renders one migration file per table-level change in
+// diff, timestamped a second apart starting at baseTime so the generated
+// file names sort in application order. driver (e.g. "postgres", "mysql",
+// "sqlite") selects the dialect-specific SQL for altered columns; it must
+// match the -driver flag the schema was introspected with.
+func GenerateMigrations(diff *SchemaDiff, packageName string, baseTime time.Time, driver string) ([]*MigrationFile, error) {
+	var files []*MigrationFile
+	next := baseTime
+
+	addFile := func(slug string, up, down []string) error {
+		data := struct {
+			Package string
+			Up      []string
+			Down    []string
+		}{Package: packageName, Up: up, Down: down}
+
+		code, err := renderMigration(data)
+		if err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("%s_%s.go", next.Format("20060102150405"), slug)
+		files = append(files, &MigrationFile{Name: name, Code: code})
+		next = next.Add(time.Second)
+		return nil
+	}
+
+	for _, table := range diff.AddedTables {
+		if err := addFile("create_"+table.Name, []string{buildCreateTableSQL(table)}, []string{buildDropTableSQL(table)}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, table := range diff.DroppedTables {
+		if err := addFile("drop_"+table.Name, []string{buildDropTableSQL(table)}, []string{buildCreateTableSQL(table)}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, tableDiff := range diff.ChangedTables {
+		up, down, err := buildAlterTableSQL(driver, tableDiff)
+		if err != nil {
+			return nil, err
+		}
+		if err := addFile("alter_"+tableDiff.Table, up, down); err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+func buildCreateTableSQL(table *Table) string {
+	defs := make([]string, 0, len(table.Columns)+1)
+	for _, column := range table.Columns {
+		def := column.Name + " " + column.Type
+		if !column.IsNullable {
+			def += " NOT NULL"
+		}
+		defs = append(defs, def)
+	}
+	if len(table.PrimaryKey) > 0 {
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(table.PrimaryKey, ", ")))
+	}
+
+	return fmt.Sprintf("CREATE TABLE %s (%s)", table.Name, strings.Join(defs, ", "))
+}
+
+func buildDropTableSQL(table *Table) string {
+	return "DROP TABLE " + table.Name
+}
+
+// buildAlterTableSQL builds the up and down SQL statements for a single
+// table's added/dropped/altered columns. It returns an error if diff alters
+// any column and driver has no way to express that (see
+// alterColumnTypeSQL).
+func buildAlterTableSQL(driver string, diff *TableDiff) (up, down []string, err error) {
+	for _, column := range diff.AddedColumns {
+		up = append(up, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", diff.Table, columnDef(column)))
+		down = append(down, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", diff.Table, column.Name))
+	}
+
+	for _, column := range diff.DroppedColumns {
+		up = append(up, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", diff.Table, column.Name))
+		down = append(down, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", diff.Table, columnDef(column)))
+	}
+
+	for _, alter := range diff.AlteredColumns {
+		upStmt, downStmt, err := alterColumnTypeSQL(driver, diff.Table, alter)
+		if err != nil {
+			return nil, nil, err
+		}
+		up = append(up, upStmt)
+		down = append(down, downStmt)
+	}
+
+	return up, down, nil
+}
+
+func columnDef(column *Column) string {
+	def := column.Name + " " + column.Type
+	if !column.IsNullable {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// alterColumnTypeSQL builds the up/down statements for changing a single
+// column's type and nullability, in the syntax driver actually supports.
+// SQLite has no ALTER COLUMN statement at all, so it returns an error rather
+// than emitting SQL that would fail when applied.
+func alterColumnTypeSQL(driver, table string, alter *ColumnDiff) (up, down string, err error) {
+	switch driver {
+	case "postgres":
+		nullability := func(column *Column) string {
+			if column.IsNullable {
+				return "DROP NOT NULL"
+			}
+			return "SET NOT NULL"
+		}
+		up = fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s, ALTER COLUMN %s %s", table, alter.Name, alter.After.Type, alter.Name, nullability(alter.After))
+		down = fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s, ALTER COLUMN %s %s", table, alter.Name, alter.Before.Type, alter.Name, nullability(alter.Before))
+	case "mysql":
+		up = fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s", table, columnDef(alter.After))
+		down = fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s", table, columnDef(alter.Before))
+	default:
+		return "", "", fmt.Errorf("migration: %s does not support altering column types (table %q, column %q)", driver, table, alter.Name)
+	}
+	return up, down, nil
+}
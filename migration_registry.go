@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const migrationsRegistryTemplate = `package %s
+
+import "github.com/uptrace/bun/migrate"
+
+var Migrations = migrate.NewMigrations()
+`
+
+// EnsureMigrationsRegistry writes the shared Migrations variable that
+// generated migration files register themselves on, unless it already
+// exists (it is only created once per output directory).
+func EnsureMigrationsRegistry(dir, packageName string) error {
+	path := filepath.Join(dir, "migrations.go")
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(fmt.Sprintf(migrationsRegistryTemplate, packageName)), 0644)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateMigrationsAlterColumnDialects(t *testing.T) {
+	diff := &SchemaDiff{
+		ChangedTables: []*TableDiff{
+			{
+				Table: "users",
+				AlteredColumns: []*ColumnDiff{
+					{
+						Name:   "age",
+						Before: &Column{Name: "age", Type: "integer"},
+						After:  &Column{Name: "age", Type: "bigint", IsNullable: true},
+					},
+				},
+			},
+		},
+	}
+	baseTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		driver  string
+		wantSQL string
+		wantErr bool
+	}{
+		{driver: "postgres", wantSQL: "ALTER TABLE users ALTER COLUMN age TYPE bigint"},
+		{driver: "mysql", wantSQL: "ALTER TABLE users MODIFY COLUMN age bigint"},
+		{driver: "sqlite", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.driver, func(t *testing.T) {
+			files, err := GenerateMigrations(diff, "migrations", baseTime, tt.driver)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GenerateMigrations(driver=%s) = %v, nil; want error", tt.driver, files)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateMigrations(driver=%s) returned unexpected error: %v", tt.driver, err)
+			}
+			if len(files) != 1 {
+				t.Fatalf("GenerateMigrations(driver=%s) returned %d files, want 1", tt.driver, len(files))
+			}
+			if !strings.Contains(files[0].Code, tt.wantSQL) {
+				t.Errorf("GenerateMigrations(driver=%s) code = %s, want it to contain %q", tt.driver, files[0].Code, tt.wantSQL)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// MySQLTransformer implements DbTransformer for MySQL using
+// INFORMATION_SCHEMA.COLUMNS and INFORMATION_SCHEMA.KEY_COLUMN_USAGE.
+type MySQLTransformer struct {
+	db     *bun.DB
+	schema string // the MySQL database name
+	strict bool
+}
+
+// NewMySQLTransformer returns a DbTransformer backed by db, introspecting
+// tables in the given database/schema. strict makes MapColumnType return an
+// error instead of falling back to "interface{}" for unknown types.
+func NewMySQLTransformer(db *bun.DB, schema string, strict bool) *MySQLTransformer {
+	return &MySQLTransformer{db: db, schema: schema, strict: strict}
+}
+
+func (m *MySQLTransformer) FetchTables(ctx context.Context) ([]string, error) {
+	var tables []string
+
+	query := `
+		SELECT table_name
+		FROM information_schema.tables
+		WHERE table_schema = ?;
+	`
+
+	if err := m.db.NewRaw(query, m.schema).Scan(ctx, &tables); err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (m *MySQLTransformer) FetchColumns(ctx context.Context, tableName string) ([]ColumnInfo, error) {
+	var rows []struct {
+		ColumnName string `bun:"column_name"`
+		DataType   string `bun:"data_type"`
+		IsNullable string `bun:"is_nullable"`
+		ColumnKey  string `bun:"column_key"`
+	}
+
+	query := `
+		SELECT column_name, data_type, is_nullable, column_key
+		FROM information_schema.columns
+		WHERE table_schema = ? AND table_name = ?
+		ORDER BY ordinal_position;
+	`
+
+	if err := m.db.NewRaw(query, m.schema, tableName).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	foreignKeys, err := m.fetchForeignKeys(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]ColumnInfo, 0, len(rows))
+	for _, row := range rows {
+		columns = append(columns, ColumnInfo{
+			Name:         row.ColumnName,
+			DataType:     row.DataType,
+			IsNullable:   row.IsNullable == "YES",
+			IsPrimaryKey: row.ColumnKey == "PRI",
+			ForeignKey:   foreignKeys[row.ColumnName],
+		})
+	}
+
+	return columns, nil
+}
+
+func (m *MySQLTransformer) fetchForeignKeys(ctx context.Context, tableName string) (map[string]*ForeignKey, error) {
+	var rows []struct {
+		ColumnName           string `bun:"column_name"`
+		ReferencedTableName  string `bun:"referenced_table_name"`
+		ReferencedColumnName string `bun:"referenced_column_name"`
+	}
+
+	query := `
+		SELECT column_name, referenced_table_name, referenced_column_name
+		FROM information_schema.key_column_usage
+		WHERE table_schema = ? AND table_name = ? AND referenced_table_name IS NOT NULL;
+	`
+
+	if err := m.db.NewRaw(query, m.schema, tableName).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*ForeignKey, len(rows))
+	for _, row := range rows {
+		result[row.ColumnName] = &ForeignKey{Table: row.ReferencedTableName, Column: row.ReferencedColumnName}
+	}
+
+	return result, nil
+}
+
+// MapColumnType maps a MySQL column type to a Go type.
+func (m *MySQLTransformer) MapColumnType(dataType string) (string, error) {
+	switch dataType {
+	case "int", "integer", "mediumint":
+		return "int", nil
+	case "bigint":
+		return "int64", nil
+	case "smallint":
+		return "int16", nil
+	case "tinyint":
+		return "int8", nil
+	case "varchar", "text", "char", "longtext", "mediumtext":
+		return "string", nil
+	case "boolean", "bool":
+		return "bool", nil
+	case "datetime", "timestamp", "date":
+		return "time.Time", nil
+	default:
+		if m.strict {
+			return "", fmt.Errorf("mysql: no Go type mapping for column type %q", dataType)
+		}
+		return "interface{}", nil // Fallback for unknown types
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import "strings"
+
+// DefaultInitialisms lists identifier fragments that should be rendered in
+// full caps when they appear as a whole underscore-separated word, mirroring
+// golint's common initialisms list.
+var DefaultInitialisms = map[string]bool{
+	"ID":   true,
+	"URL":  true,
+	"API":  true,
+	"HTTP": true,
+	"UUID": true,
+	"SQL":  true,
+	"DB":   true,
+	"JSON": true,
+}
+
+// Namer converts snake_case table and column names into idiomatic Go
+// identifiers.
+type Namer struct {
+	Initialisms map[string]bool
+	Singularize bool
+}
+
+// NewNamer returns a Namer seeded with DefaultInitialisms plus any extra
+// acronyms the caller supplies, singularizing table names unless
+// singularize is false (the -no-singularize flag).
+func NewNamer(singularize bool, extraInitialisms []string) *Namer {
+	initialisms := make(map[string]bool, len(DefaultInitialisms)+len(extraInitialisms))
+	for k, v := range DefaultInitialisms {
+		initialisms[k] = v
+	}
+	for _, acronym := range extraInitialisms {
+		initialisms[strings.ToUpper(acronym)] = true
+	}
+	return &Namer{Initialisms: initialisms, Singularize: singularize}
+}
+
+// FieldName converts a snake_case column or table name into an exported Go
+// identifier, e.g. "user_id" -> "UserID", "created_at" -> "CreatedAt".
+func (n *Namer) FieldName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		if upper := strings.ToUpper(part); n.Initialisms[upper] {
+			parts[i] = upper
+			continue
+		}
+		parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+	}
+	return strings.Join(parts, "")
+}
+
+// TypeName converts a table name into an exported Go type name, singularizing
+// it unless Namer.Singularize is false, e.g. "users" -> "User",
+// "order_items" -> "OrderItem".
+func (n *Namer) TypeName(tableName string) string {
+	name := n.FieldName(tableName)
+	if n.Singularize {
+		name = singularize(name)
+	}
+	return name
+}
+
+// singularizeExceptions lists words that end in "s" but are already
+// singular, so the bare-trailing-"s" rule below would otherwise mangle them
+// (e.g. "status" -> "statu").
+var singularizeExceptions = map[string]bool{
+	"status":  true,
+	"series":  true,
+	"species": true,
+	"bus":     true,
+	"gas":     true,
+}
+
+// singularize strips a trailing plural suffix from an already-CamelCased
+// name. It only handles the common English patterns bun-generate is likely
+// to see in table names, not the full language.
+func singularize(name string) string {
+	if singularizeExceptions[strings.ToLower(name)] {
+		return name
+	}
+	switch {
+	case strings.HasSuffix(name, "ies") && len(name) > 3:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(name, "ses") && len(name) > 3:
+		return name[:len(name)-2]
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") && len(name) > 1:
+		return name[:len(name)-1]
+	default:
+		return name
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import "testing"
+
+func TestNamerFieldName(t *testing.T) {
+	namer := NewNamer(true, nil)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"user_id", "UserID"},
+		{"created_at", "CreatedAt"},
+		{"id", "ID"},
+		{"api_key", "APIKey"},
+		{"name", "Name"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := namer.FieldName(tt.name); got != tt.want {
+			t.Errorf("FieldName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNamerFieldNameExtraInitialisms(t *testing.T) {
+	namer := NewNamer(true, []string{"vin"})
+
+	if got, want := namer.FieldName("vin_number"), "VINNumber"; got != want {
+		t.Errorf("FieldName(%q) = %q, want %q", "vin_number", got, want)
+	}
+}
+
+func TestNamerTypeName(t *testing.T) {
+	tests := []struct {
+		table string
+		want  string
+	}{
+		{"users", "User"},
+		{"order_items", "OrderItem"},
+		{"addresses", "Address"},
+		{"categories", "Category"},
+		{"status", "Status"},
+	}
+
+	namer := NewNamer(true, nil)
+	for _, tt := range tests {
+		if got := namer.TypeName(tt.table); got != tt.want {
+			t.Errorf("TypeName(%q) = %q, want %q", tt.table, got, tt.want)
+		}
+	}
+}
+
+func TestNamerTypeNameNoSingularize(t *testing.T) {
+	namer := NewNamer(false, nil)
+
+	if got, want := namer.TypeName("users"), "Users"; got != want {
+		t.Errorf("TypeName(%q) = %q, want %q", "users", got, want)
+	}
+}
+
+func TestSingularize(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Users", "User"},
+		{"Categories", "Category"},
+		{"Addresses", "Address"},
+		{"Status", "Status"},
+		{"Data", "Data"}, // no plural suffix we handle; left unchanged
+		{"A", "A"},
+	}
+
+	for _, tt := range tests {
+		if got := singularize(tt.name); got != tt.want {
+			t.Errorf("singularize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
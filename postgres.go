@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// PostgresTransformer implements DbTransformer for PostgreSQL using
+// information_schema joined with pg_constraint for primary/foreign keys.
+type PostgresTransformer struct {
+	db              *bun.DB
+	schema          string
+	strict          bool
+	decimalAsString bool
+}
+
+// NewPostgresTransformer returns a DbTransformer backed by db, introspecting
+// tables in the given schema (e.g. "public"). strict makes MapColumnType
+// return an error instead of falling back to "interface{}" for unknown
+// types; decimalAsString maps numeric/decimal columns to string instead of
+// decimal.Decimal.
+func NewPostgresTransformer(db *bun.DB, schema string, strict, decimalAsString bool) *PostgresTransformer {
+	return &PostgresTransformer{db: db, schema: schema, strict: strict, decimalAsString: decimalAsString}
+}
+
+func (p *PostgresTransformer) FetchTables(ctx context.Context) ([]string, error) {
+	var tables []string
+
+	query := `
+		SELECT table_name
+		FROM information_schema.tables
+		WHERE table_schema = ?;
+	`
+
+	err := p.db.NewRaw(query, p.schema).Scan(ctx, &tables)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (p *PostgresTransformer) FetchColumns(ctx context.Context, tableName string) ([]ColumnInfo, error) {
+	var rows []struct {
+		ColumnName string `bun:"column_name"`
+		DataType   string `bun:"data_type"`
+		UdtName    string `bun:"udt_name"`
+		IsNullable string `bun:"is_nullable"`
+	}
+
+	query := `
+		SELECT column_name::text, data_type::text, udt_name::text, is_nullable::text
+		FROM information_schema.columns
+		WHERE table_schema = ? AND table_name = ?
+		ORDER BY ordinal_position;
+	`
+
+	if err := p.db.NewRaw(query, p.schema, tableName).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	primaryKeys, err := p.fetchPrimaryKeys(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	foreignKeys, err := p.fetchForeignKeys(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]ColumnInfo, 0, len(rows))
+	for _, row := range rows {
+		dataType := row.DataType
+		if dataType == "ARRAY" {
+			// udt_name for an array column is the element's internal pg_type
+			// name prefixed with an underscore, e.g. "_int4" for integer[].
+			dataType = strings.TrimPrefix(row.UdtName, "_") + "[]"
+		}
+
+		columns = append(columns, ColumnInfo{
+			Name:         row.ColumnName,
+			DataType:     dataType,
+			IsNullable:   row.IsNullable == "YES",
+			IsPrimaryKey: primaryKeys[row.ColumnName],
+			ForeignKey:   foreignKeys[row.ColumnName],
+		})
+	}
+
+	return columns, nil
+}
+
+func (p *PostgresTransformer) fetchPrimaryKeys(ctx context.Context, tableName string) (map[string]bool, error) {
+	var columns []string
+
+	query := `
+		SELECT a.attname
+		FROM pg_constraint c
+		JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+		WHERE c.contype = 'p' AND c.conrelid = ?::regclass;
+	`
+
+	if err := p.db.NewRaw(query, p.schema+"."+tableName).Scan(ctx, &columns); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		result[column] = true
+	}
+
+	return result, nil
+}
+
+func (p *PostgresTransformer) fetchForeignKeys(ctx context.Context, tableName string) (map[string]*ForeignKey, error) {
+	var rows []struct {
+		ColumnName       string `bun:"column_name"`
+		ForeignTableName string `bun:"foreign_table_name"`
+		ForeignColumn    string `bun:"foreign_column_name"`
+	}
+
+	query := `
+		SELECT
+			a.attname AS column_name,
+			fc.relname AS foreign_table_name,
+			fa.attname AS foreign_column_name
+		FROM pg_constraint c
+		JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+		JOIN pg_class fc ON fc.oid = c.confrelid
+		JOIN pg_attribute fa ON fa.attrelid = c.confrelid AND fa.attnum = ANY(c.confkey)
+		WHERE c.contype = 'f' AND c.conrelid = ?::regclass;
+	`
+
+	if err := p.db.NewRaw(query, p.schema+"."+tableName).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*ForeignKey, len(rows))
+	for _, row := range rows {
+		result[row.ColumnName] = &ForeignKey{Table: row.ForeignTableName, Column: row.ForeignColumn}
+	}
+
+	return result, nil
+}
+
+// postgresScalarTypes maps both information_schema.columns.data_type values
+// and the bare (underscore-stripped) udt_name of array element types to Go
+// types.
+var postgresScalarTypes = map[string]string{
+	"integer":                     "int",
+	"int4":                        "int",
+	"bigint":                      "int64",
+	"int8":                        "int64",
+	"smallint":                    "int16",
+	"int2":                        "int16",
+	"real":                        "float32",
+	"float4":                      "float32",
+	"double precision":            "float64",
+	"float8":                      "float64",
+	"numeric":                     "decimal.Decimal",
+	"decimal":                     "decimal.Decimal",
+	"text":                        "string",
+	"character varying":           "string",
+	"varchar":                     "string",
+	"character":                   "string",
+	"bpchar":                      "string",
+	"boolean":                     "bool",
+	"bool":                        "bool",
+	"uuid":                        "uuid.UUID",
+	"json":                        "json.RawMessage",
+	"jsonb":                       "json.RawMessage",
+	"bytea":                       "[]byte",
+	"timestamp without time zone": "time.Time",
+	"timestamp":                   "time.Time",
+	"timestamp with time zone":    "time.Time",
+	"timestamptz":                 "time.Time",
+	"date":                        "time.Time",
+	"interval":                    "time.Duration",
+}
+
+// MapColumnType maps a Postgres column type to a Go type. Array types
+// (passed in as "<element>[]", see FetchColumns) map to a Go slice of the
+// element's mapped type.
+func (p *PostgresTransformer) MapColumnType(dataType string) (string, error) {
+	if elem, ok := strings.CutSuffix(dataType, "[]"); ok {
+		var goElem string
+		switch {
+		case p.decimalAsString && (elem == "numeric" || elem == "decimal"):
+			goElem = "string"
+		default:
+			var ok bool
+			goElem, ok = postgresScalarTypes[elem]
+			if !ok {
+				if p.strict {
+					return "", fmt.Errorf("postgres: no Go type mapping for array element type %q", elem)
+				}
+				goElem = "interface{}"
+			}
+		}
+		return "[]" + goElem, nil
+	}
+
+	if p.decimalAsString && (dataType == "numeric" || dataType == "decimal") {
+		return "string", nil
+	}
+
+	if goType, ok := postgresScalarTypes[dataType]; ok {
+		return goType, nil
+	}
+
+	if p.strict {
+		return "", fmt.Errorf("postgres: no Go type mapping for column type %q", dataType)
+	}
+	return "interface{}", nil // Fallback for unknown types
+}
@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestPostgresMapColumnType(t *testing.T) {
+	tests := []struct {
+		name            string
+		strict          bool
+		decimalAsString bool
+		dataType        string
+		want            string
+		wantErr         bool
+	}{
+		{name: "scalar int", dataType: "integer", want: "int"},
+		{name: "scalar uuid", dataType: "uuid", want: "uuid.UUID"},
+		{name: "scalar decimal default", dataType: "numeric", want: "decimal.Decimal"},
+		{name: "scalar decimal as string", decimalAsString: true, dataType: "numeric", want: "string"},
+		{name: "array of known scalar", dataType: "int4[]", want: "[]int"},
+		{name: "array of decimal default", dataType: "numeric[]", want: "[]decimal.Decimal"},
+		{name: "array of decimal as string", decimalAsString: true, dataType: "numeric[]", want: "[]string"},
+		{name: "array of decimal alias as string", decimalAsString: true, dataType: "decimal[]", want: "[]string"},
+		{name: "unknown scalar non-strict", dataType: "tsvector", want: "interface{}"},
+		{name: "unknown array element non-strict", dataType: "tsvector[]", want: "[]interface{}"},
+		{name: "unknown scalar strict", strict: true, dataType: "tsvector", wantErr: true},
+		{name: "unknown array element strict", strict: true, dataType: "tsvector[]", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPostgresTransformer(nil, "public", tt.strict, tt.decimalAsString)
+
+			got, err := p.MapColumnType(tt.dataType)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("MapColumnType(%q) = %q, nil; want error", tt.dataType, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MapColumnType(%q) returned unexpected error: %v", tt.dataType, err)
+			}
+			if got != tt.want {
+				t.Errorf("MapColumnType(%q) = %q, want %q", tt.dataType, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import "context"
+
+// Schema is the intermediate representation of an introspected database. It
+// is populated by the DbTransformer implementations and consumed by the
+// template-based model (and, later, migration) generators.
+type Schema struct {
+	Tables []*Table
+}
+
+// Table is the IR for a single database table.
+//
+// Unique/index constraints aren't tracked here: no DbTransformer introspects
+// them yet, so migrations never emit CREATE INDEX/DROP INDEX statements —
+// only table and column level changes.
+type Table struct {
+	Name       string
+	Columns    []*Column
+	PrimaryKey []string
+}
+
+// Column is the IR for a single table column.
+type Column struct {
+	Name       string
+	Type       string // dialect-native type, before Go type mapping
+	IsNullable bool
+	Reference  *Reference
+}
+
+// Reference describes a column's foreign key reference to another table's
+// column.
+type Reference struct {
+	Table  string
+	Column string
+}
+
+// BuildTable converts the raw columns reported by a DbTransformer into the
+// Table IR.
+func BuildTable(tableName string, columns []ColumnInfo) *Table {
+	table := &Table{Name: tableName}
+
+	for _, c := range columns {
+		column := &Column{Name: c.Name, Type: c.DataType, IsNullable: c.IsNullable}
+		if c.ForeignKey != nil {
+			column.Reference = &Reference{Table: c.ForeignKey.Table, Column: c.ForeignKey.Column}
+		}
+
+		table.Columns = append(table.Columns, column)
+		if c.IsPrimaryKey {
+			table.PrimaryKey = append(table.PrimaryKey, c.Name)
+		}
+	}
+
+	return table
+}
+
+// BuildSchema introspects every table reported by transformer and assembles
+// them into a Schema.
+func BuildSchema(ctx context.Context, transformer DbTransformer, tables []string) (*Schema, error) {
+	schema := &Schema{}
+
+	for _, tableName := range tables {
+		columns, err := transformer.FetchColumns(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		schema.Tables = append(schema.Tables, BuildTable(tableName, columns))
+	}
+
+	return schema, nil
+}
+
+// Table looks up a table by name, or nil if the schema has none by that name.
+func (s *Schema) Table(name string) *Table {
+	for _, table := range s.Tables {
+		if table.Name == name {
+			return table
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import "sort"
+
+// SchemaDiff describes the changes needed to go from an old Schema to a new
+// one. It is the basis for incremental migration generation.
+type SchemaDiff struct {
+	AddedTables   []*Table
+	DroppedTables []*Table
+	ChangedTables []*TableDiff
+}
+
+// TableDiff describes the column-level changes to an existing table.
+type TableDiff struct {
+	Table          string
+	AddedColumns   []*Column
+	DroppedColumns []*Column
+	AlteredColumns []*ColumnDiff
+}
+
+// ColumnDiff describes a column whose definition changed between schemas.
+type ColumnDiff struct {
+	Name   string
+	Before *Column
+	After  *Column
+}
+
+// IsEmpty reports whether the diff contains no changes at all.
+func (d *SchemaDiff) IsEmpty() bool {
+	return len(d.AddedTables) == 0 && len(d.DroppedTables) == 0 && len(d.ChangedTables) == 0
+}
+
+// DiffSchema compares old against new and returns the set of changes needed
+// to bring old up to date with new.
+func DiffSchema(old, new *Schema) *SchemaDiff {
+	diff := &SchemaDiff{}
+
+	oldTables := make(map[string]*Table)
+	if old != nil {
+		for _, table := range old.Tables {
+			oldTables[table.Name] = table
+		}
+	}
+
+	newTables := make(map[string]*Table)
+	for _, table := range new.Tables {
+		newTables[table.Name] = table
+		if _, ok := oldTables[table.Name]; !ok {
+			diff.AddedTables = append(diff.AddedTables, table)
+		}
+	}
+
+	for name, oldTable := range oldTables {
+		if _, ok := newTables[name]; !ok {
+			diff.DroppedTables = append(diff.DroppedTables, oldTable)
+		}
+	}
+	sort.Slice(diff.DroppedTables, func(i, j int) bool {
+		return diff.DroppedTables[i].Name < diff.DroppedTables[j].Name
+	})
+
+	for name, newTable := range newTables {
+		oldTable, ok := oldTables[name]
+		if !ok {
+			continue
+		}
+		if tableDiff := diffTable(oldTable, newTable); tableDiff != nil {
+			diff.ChangedTables = append(diff.ChangedTables, tableDiff)
+		}
+	}
+	sort.Slice(diff.ChangedTables, func(i, j int) bool {
+		return diff.ChangedTables[i].Table < diff.ChangedTables[j].Table
+	})
+
+	return diff
+}
+
+func diffTable(old, new *Table) *TableDiff {
+	diff := &TableDiff{Table: new.Name}
+
+	oldColumns := make(map[string]*Column)
+	for _, column := range old.Columns {
+		oldColumns[column.Name] = column
+	}
+
+	newColumns := make(map[string]*Column)
+	for _, column := range new.Columns {
+		newColumns[column.Name] = column
+		if _, ok := oldColumns[column.Name]; !ok {
+			diff.AddedColumns = append(diff.AddedColumns, column)
+		}
+	}
+
+	for name, oldColumn := range oldColumns {
+		if _, ok := newColumns[name]; !ok {
+			diff.DroppedColumns = append(diff.DroppedColumns, oldColumn)
+		}
+	}
+	sort.Slice(diff.DroppedColumns, func(i, j int) bool {
+		return diff.DroppedColumns[i].Name < diff.DroppedColumns[j].Name
+	})
+
+	for name, newColumn := range newColumns {
+		oldColumn, ok := oldColumns[name]
+		if !ok || columnsEqual(oldColumn, newColumn) {
+			continue
+		}
+		diff.AlteredColumns = append(diff.AlteredColumns, &ColumnDiff{Name: name, Before: oldColumn, After: newColumn})
+	}
+	sort.Slice(diff.AlteredColumns, func(i, j int) bool {
+		return diff.AlteredColumns[i].Name < diff.AlteredColumns[j].Name
+	})
+
+	if len(diff.AddedColumns) == 0 && len(diff.DroppedColumns) == 0 && len(diff.AlteredColumns) == 0 {
+		return nil
+	}
+
+	return diff
+}
+
+func columnsEqual(a, b *Column) bool {
+	if a.Type != b.Type || a.IsNullable != b.IsNullable {
+		return false
+	}
+	if (a.Reference == nil) != (b.Reference == nil) {
+		return false
+	}
+	if a.Reference != nil && (*a.Reference != *b.Reference) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func fiveTableSchema(prefix string) *Schema {
+	schema := &Schema{}
+	for _, name := range []string{"zebras", "apples", "mangoes", "bananas", "cherries"} {
+		schema.Tables = append(schema.Tables, &Table{Name: prefix + name})
+	}
+	return schema
+}
+
+// TestDiffSchemaDeterministic guards against the dropped/changed tables and
+// columns being built by ranging over maps without a subsequent sort, which
+// previously made DiffSchema's output order vary run to run.
+func TestDiffSchemaDeterministic(t *testing.T) {
+	old := fiveTableSchema("")
+	new := &Schema{
+		Tables: []*Table{
+			{
+				Name: "zebras",
+				Columns: []*Column{
+					{Name: "id", Type: "integer"},
+					{Name: "stripes", Type: "integer"},
+				},
+			},
+		},
+	}
+	old.Tables[0].Columns = []*Column{
+		{Name: "id", Type: "integer"},
+		{Name: "legs", Type: "integer"},
+		{Name: "name", Type: "text"},
+	}
+
+	var names [][]string
+	var columnDiffs [][]string
+	for i := 0; i < 20; i++ {
+		diff := DiffSchema(old, new)
+
+		var dropped []string
+		for _, table := range diff.DroppedTables {
+			dropped = append(dropped, table.Name)
+		}
+		names = append(names, dropped)
+
+		var changed []string
+		for _, table := range diff.ChangedTables {
+			changed = append(changed, table.Table+":"+columnNames(table))
+		}
+		columnDiffs = append(columnDiffs, changed)
+	}
+
+	for i := 1; i < len(names); i++ {
+		if !reflect.DeepEqual(names[i], names[0]) {
+			t.Fatalf("DroppedTables order varied across runs: run 0 = %v, run %d = %v", names[0], i, names[i])
+		}
+		if !reflect.DeepEqual(columnDiffs[i], columnDiffs[0]) {
+			t.Fatalf("ChangedTables column order varied across runs: run 0 = %v, run %d = %v", columnDiffs[0], i, columnDiffs[i])
+		}
+	}
+}
+
+func columnNames(diff *TableDiff) string {
+	var s string
+	for _, c := range diff.DroppedColumns {
+		s += "drop:" + c.Name + ","
+	}
+	for _, c := range diff.AlteredColumns {
+		s += "alter:" + c.Name + ","
+	}
+	return s
+}
+
+// TestGenerateMigrationsDeterministic guards against DiffSchema's previously
+// nondeterministic ordering leaking into migration file names, since
+// GenerateMigrations assigns sequentially-incrementing timestamps in the
+// order it's handed the diff's table slices.
+func TestGenerateMigrationsDeterministic(t *testing.T) {
+	old := fiveTableSchema("")
+	new := &Schema{}
+	baseTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var fileNames [][]string
+	for i := 0; i < 20; i++ {
+		diff := DiffSchema(old, new)
+
+		files, err := GenerateMigrations(diff, "migrations", baseTime, "postgres")
+		if err != nil {
+			t.Fatalf("GenerateMigrations returned error: %v", err)
+		}
+
+		var names []string
+		for _, f := range files {
+			names = append(names, f.Name)
+		}
+		fileNames = append(fileNames, names)
+	}
+
+	for i := 1; i < len(fileNames); i++ {
+		if !reflect.DeepEqual(fileNames[i], fileNames[0]) {
+			t.Fatalf("migration file name order varied across runs: run 0 = %v, run %d = %v", fileNames[0], i, fileNames[i])
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+const snapshotFileName = "schema_snapshot.json"
+
+// LoadSnapshot reads the last-known Schema persisted alongside a migrations
+// directory. It returns (nil, nil) if no snapshot exists yet, which callers
+// should treat as "every table is new".
+func LoadSnapshot(dir string) (*Schema, error) {
+	data, err := os.ReadFile(filepath.Join(dir, snapshotFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+
+	return &schema, nil
+}
+
+// SaveSnapshot persists schema as the new last-known state for dir.
+func SaveSnapshot(dir string, schema *Schema) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(schema, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, snapshotFileName), data, 0644)
+}
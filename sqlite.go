@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// SQLiteTransformer implements DbTransformer for SQLite using the
+// PRAGMA table_info and PRAGMA foreign_key_list introspection pragmas.
+type SQLiteTransformer struct {
+	db     *bun.DB
+	strict bool
+}
+
+// NewSQLiteTransformer returns a DbTransformer backed by db. strict makes
+// MapColumnType return an error instead of falling back to "interface{}"
+// for unknown types.
+func NewSQLiteTransformer(db *bun.DB, strict bool) *SQLiteTransformer {
+	return &SQLiteTransformer{db: db, strict: strict}
+}
+
+func (s *SQLiteTransformer) FetchTables(ctx context.Context) ([]string, error) {
+	var tables []string
+
+	query := `
+		SELECT name
+		FROM sqlite_master
+		WHERE type = 'table' AND name NOT LIKE 'sqlite_%';
+	`
+
+	if err := s.db.NewRaw(query).Scan(ctx, &tables); err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (s *SQLiteTransformer) FetchColumns(ctx context.Context, tableName string) ([]ColumnInfo, error) {
+	var rows []struct {
+		Name    string `bun:"name"`
+		Type    string `bun:"type"`
+		NotNull int    `bun:"notnull"`
+		Pk      int    `bun:"pk"`
+	}
+
+	query := "PRAGMA table_info(" + quoteIdentifier(tableName) + ");"
+	if err := s.db.NewRaw(query).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	foreignKeys, err := s.fetchForeignKeys(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]ColumnInfo, 0, len(rows))
+	for _, row := range rows {
+		columns = append(columns, ColumnInfo{
+			Name:         row.Name,
+			DataType:     row.Type,
+			IsNullable:   row.NotNull == 0,
+			IsPrimaryKey: row.Pk > 0,
+			ForeignKey:   foreignKeys[row.Name],
+		})
+	}
+
+	return columns, nil
+}
+
+func (s *SQLiteTransformer) fetchForeignKeys(ctx context.Context, tableName string) (map[string]*ForeignKey, error) {
+	var rows []struct {
+		From string `bun:"from"`
+		// Table is the referenced table, named "table" in the pragma output.
+		Table string `bun:"table"`
+		To    string `bun:"to"`
+	}
+
+	query := "PRAGMA foreign_key_list(" + quoteIdentifier(tableName) + ");"
+	if err := s.db.NewRaw(query).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*ForeignKey, len(rows))
+	for _, row := range rows {
+		result[row.From] = &ForeignKey{Table: row.Table, Column: row.To}
+	}
+
+	return result, nil
+}
+
+// quoteIdentifier double-quotes a SQLite identifier for interpolation into
+// PRAGMA statements, which don't accept bind parameters for table names.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// MapColumnType maps a SQLite column type to a Go type. SQLite column types
+// are free-form declared strings, not a fixed enum, so this matches
+// case-insensitively and falls back to SQLite's own type-affinity substring
+// rules (e.g. any type containing "INT" gets integer affinity) rather than
+// requiring an exact "INTEGER"/"TEXT"/... literal.
+func (s *SQLiteTransformer) MapColumnType(dataType string) (string, error) {
+	upper := strings.ToUpper(strings.TrimSpace(dataType))
+	switch {
+	case strings.Contains(upper, "BOOL"):
+		return "bool", nil
+	case strings.Contains(upper, "DATE") || strings.Contains(upper, "TIME"):
+		return "time.Time", nil
+	case strings.Contains(upper, "INT"):
+		return "int64", nil
+	case strings.Contains(upper, "CHAR") || strings.Contains(upper, "CLOB") || strings.Contains(upper, "TEXT"):
+		return "string", nil
+	case strings.Contains(upper, "REAL") || strings.Contains(upper, "FLOA") || strings.Contains(upper, "DOUB"):
+		return "float64", nil
+	case strings.Contains(upper, "BLOB") || upper == "":
+		return "[]byte", nil
+	default:
+		if s.strict {
+			return "", fmt.Errorf("sqlite: no Go type mapping for column type %q", dataType)
+		}
+		return "interface{}", nil // Fallback for unknown types
+	}
+}
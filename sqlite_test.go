@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestSQLiteMapColumnType(t *testing.T) {
+	tests := []struct {
+		name     string
+		strict   bool
+		dataType string
+		want     string
+		wantErr  bool
+	}{
+		{name: "uppercase int", dataType: "INTEGER", want: "int64"},
+		{name: "lowercase int", dataType: "integer", want: "int64"},
+		{name: "sized int variant", dataType: "BIGINT", want: "int64"},
+		{name: "uppercase text", dataType: "TEXT", want: "string"},
+		{name: "varchar affinity", dataType: "VARCHAR(255)", want: "string"},
+		{name: "mixed case real", dataType: "Real", want: "float64"},
+		{name: "double affinity", dataType: "DOUBLE PRECISION", want: "float64"},
+		{name: "uppercase blob", dataType: "BLOB", want: "[]byte"},
+		{name: "uppercase boolean", dataType: "BOOLEAN", want: "bool"},
+		{name: "lowercase datetime", dataType: "datetime", want: "time.Time"},
+		{name: "lowercase date", dataType: "date", want: "time.Time"},
+		{name: "unknown non-strict", dataType: "NUMERIC", want: "interface{}"},
+		{name: "unknown strict", strict: true, dataType: "NUMERIC", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSQLiteTransformer(nil, tt.strict)
+
+			got, err := s.MapColumnType(tt.dataType)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("MapColumnType(%q) = %q, nil; want error", tt.dataType, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MapColumnType(%q) returned unexpected error: %v", tt.dataType, err)
+			}
+			if got != tt.want {
+				t.Errorf("MapColumnType(%q) = %q, want %q", tt.dataType, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// modelTemplateSrc renders a single bun model file for one table.
+const modelTemplateSrc = `package {{.Package}}
+
+import (
+	"github.com/uptrace/bun"
+{{range .Imports}}	"{{.}}"
+{{end}})
+
+type {{.TypeName}} struct {
+	bun.BaseModel {{backtick}}bun:"table:{{.Table.Name}}"{{backtick}}
+
+{{range .Fields}}	{{.FieldName}} {{.GoType}} {{backtick}}bun:"{{.DBName}}{{if .IsPrimaryKey}},pk{{end}}"{{backtick}}
+{{end}}{{range .BelongsTo}}	{{.FieldName}} *{{.TypeName}} {{backtick}}bun:"rel:belongs-to,join:{{.JoinField}}={{.JoinRefField}}"{{backtick}}
+{{end}}{{range .HasMany}}	{{.FieldName}} []*{{.TypeName}} {{backtick}}bun:"rel:has-many,join:{{.JoinField}}={{.JoinRefField}}"{{backtick}}
+{{end}}}
+`
+
+var modelTemplate = template.Must(template.New("model").Funcs(template.FuncMap{
+	"backtick": func() string { return "`" },
+}).Parse(modelTemplateSrc))
+
+// fieldData is the per-column view handed to the model template.
+type fieldData struct {
+	FieldName    string
+	DBName       string
+	GoType       string
+	IsPrimaryKey bool
+}
+
+// relationData is the per-relation view handed to the model template.
+type relationData struct {
+	FieldName    string
+	TypeName     string
+	JoinField    string
+	JoinRefField string
+}
+
+// modelData is the complete view handed to the model template.
+type modelData struct {
+	Package   string
+	TypeName  string
+	Table     *Table
+	Fields    []fieldData
+	BelongsTo []relationData
+	HasMany   []relationData
+	Imports   []string
+}
+
+// goTypeImports maps a substring that can appear in a generated Go type to
+// the import path it requires.
+var goTypeImports = map[string]string{
+	"time.Time":       "time",
+	"time.Duration":   "time",
+	"uuid.UUID":       "github.com/google/uuid",
+	"decimal.Decimal": "github.com/shopspring/decimal",
+	"json.RawMessage": "encoding/json",
+}
+
+// BuildModelData assembles the template input for table, including
+// belongs-to relations detected from its own foreign keys and has-many
+// relations detected from other tables in schema that reference it.
+func BuildModelData(schema *Schema, table *Table, transformer DbTransformer, packageName string, namer *Namer) (*modelData, error) {
+	primaryKeys := make(map[string]bool, len(table.PrimaryKey))
+	for _, name := range table.PrimaryKey {
+		primaryKeys[name] = true
+	}
+
+	data := &modelData{
+		Package:  packageName,
+		TypeName: namer.TypeName(table.Name),
+		Table:    table,
+	}
+
+	imports := make(map[string]bool)
+
+	belongsToCount := make(map[string]int, len(table.Columns))
+	for _, column := range table.Columns {
+		if column.Reference != nil {
+			belongsToCount[column.Reference.Table]++
+		}
+	}
+
+	for _, column := range table.Columns {
+		goType, err := transformer.MapColumnType(column.Type)
+		if err != nil {
+			return nil, err
+		}
+		if column.IsNullable && goType != "interface{}" {
+			goType = "*" + goType
+		}
+
+		for substr, path := range goTypeImports {
+			if strings.Contains(goType, substr) {
+				imports[path] = true
+			}
+		}
+
+		data.Fields = append(data.Fields, fieldData{
+			FieldName:    namer.FieldName(column.Name),
+			DBName:       column.Name,
+			GoType:       goType,
+			IsPrimaryKey: primaryKeys[column.Name],
+		})
+
+		if column.Reference != nil {
+			fieldName := namer.TypeName(column.Reference.Table)
+			if belongsToCount[column.Reference.Table] > 1 {
+				// Multiple FK columns to the same table (e.g. buyer_id and
+				// seller_id both referencing users) would otherwise collide
+				// on the same field name; derive it from the column instead.
+				fieldName = relationFieldPrefix(namer, column.Name)
+			}
+
+			data.BelongsTo = append(data.BelongsTo, relationData{
+				FieldName:    fieldName,
+				TypeName:     namer.TypeName(column.Reference.Table),
+				JoinField:    column.Name,
+				JoinRefField: column.Reference.Column,
+			})
+		}
+	}
+
+	for _, other := range schema.Tables {
+		if other.Name == table.Name {
+			continue
+		}
+
+		hasManyCount := 0
+		for _, column := range other.Columns {
+			if column.Reference != nil && column.Reference.Table == table.Name {
+				hasManyCount++
+			}
+		}
+
+		for _, column := range other.Columns {
+			if column.Reference == nil || column.Reference.Table != table.Name {
+				continue
+			}
+
+			fieldName := namer.FieldName(other.Name)
+			if hasManyCount > 1 {
+				// Multiple FK columns on the same child table (e.g. orders
+				// with both buyer_id and seller_id referencing users) would
+				// otherwise collide on the same field name.
+				fieldName = relationFieldPrefix(namer, column.Name) + namer.FieldName(other.Name)
+			}
+
+			data.HasMany = append(data.HasMany, relationData{
+				FieldName:    fieldName,
+				TypeName:     namer.TypeName(other.Name),
+				JoinField:    column.Reference.Column,
+				JoinRefField: column.Name,
+			})
+		}
+	}
+
+	for path := range imports {
+		data.Imports = append(data.Imports, path)
+	}
+	sort.Strings(data.Imports)
+
+	return data, nil
+}
+
+// relationFieldPrefix derives an identifier from a foreign key column name
+// for use when more than one FK column needs a distinct relation field name,
+// e.g. "buyer_id" -> "Buyer", "seller_id" -> "Seller".
+func relationFieldPrefix(namer *Namer, columnName string) string {
+	trimmed := strings.TrimSuffix(columnName, "_id")
+	trimmed = strings.TrimSuffix(trimmed, "ID")
+	if trimmed == "" {
+		trimmed = columnName
+	}
+	return namer.FieldName(trimmed)
+}
+
+// RenderModel renders data through modelTemplate and formats the result with
+// go/format.
+func RenderModel(data *modelData) (string, error) {
+	var buf bytes.Buffer
+	if err := modelTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}